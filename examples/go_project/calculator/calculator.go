@@ -1,12 +1,19 @@
 // Package calculator provides simple arithmetic operations.
 //
 // This package implements basic calculator functionality including
-// addition, subtraction, multiplication, and division operations
-// with appropriate error handling.
+// addition, subtraction, multiplication, division, and modulo operations
+// on both ints and float64s, a recursive-descent expression evaluator for
+// full infix expressions, and a stateful Calculator type with history,
+// memory, and undo for REPL-style usage, all with appropriate error
+// handling.
 package calculator
 
 import "errors"
 
+// ErrDivisionByZero is returned whenever a division or modulo operation is
+// attempted with a zero divisor. Callers can test for it with errors.Is.
+var ErrDivisionByZero = errors.New("division by zero")
+
 // Add returns the sum of two integers.
 //
 // Parameters:
@@ -49,7 +56,7 @@ func Multiply(a, b int) int {
 // Returns the quotient and an error if division by zero is attempted.
 func Divide(a, b int) (int, error) {
 	if b == 0 {
-		return 0, errors.New("division by zero")
+		return 0, ErrDivisionByZero
 	}
 	return a / b, nil
 }
@@ -0,0 +1,246 @@
+package calculator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ErrUnbalancedParens is returned when an expression passed to Evaluate has
+// an opening parenthesis that is never closed. An unmatched closing
+// parenthesis is reported as ErrUnexpectedToken.
+var ErrUnbalancedParens = errors.New("unbalanced parentheses")
+
+// ErrUnexpectedToken is returned when Evaluate encounters a token it cannot
+// consume at its current position in the expression.
+var ErrUnexpectedToken = errors.New("unexpected token")
+
+// tokenKind identifies the lexical class of a token produced by tokenize.
+type tokenKind int
+
+const (
+	tokNumber tokenKind = iota
+	tokPlus
+	tokMinus
+	tokStar
+	tokSlash
+	tokPercent
+	tokLParen
+	tokRParen
+	tokEOF
+)
+
+// token is a single lexical unit produced by tokenize. num is only
+// meaningful when kind is tokNumber.
+type token struct {
+	kind tokenKind
+	num  float64
+}
+
+// tokenize converts expr into a stream of tokens, skipping whitespace and
+// recognizing integers, floats (with an optional decimal point and
+// exponent), the operators +, -, *, /, %, and parentheses.
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+	runes := []rune(expr)
+	i := 0
+	for i < len(runes) {
+		r := runes[i]
+		switch {
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			i++
+		case r == '+':
+			tokens = append(tokens, token{kind: tokPlus})
+			i++
+		case r == '-':
+			tokens = append(tokens, token{kind: tokMinus})
+			i++
+		case r == '*':
+			tokens = append(tokens, token{kind: tokStar})
+			i++
+		case r == '/':
+			tokens = append(tokens, token{kind: tokSlash})
+			i++
+		case r == '%':
+			tokens = append(tokens, token{kind: tokPercent})
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokLParen})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokRParen})
+			i++
+		case (r >= '0' && r <= '9') || r == '.':
+			start := i
+			for i < len(runes) && ((runes[i] >= '0' && runes[i] <= '9') || runes[i] == '.') {
+				i++
+			}
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				i++
+				if i < len(runes) && (runes[i] == '+' || runes[i] == '-') {
+					i++
+				}
+				for i < len(runes) && runes[i] >= '0' && runes[i] <= '9' {
+					i++
+				}
+			}
+			lit := string(runes[start:i])
+			n, err := strconv.ParseFloat(lit, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid number %q", ErrUnexpectedToken, lit)
+			}
+			tokens = append(tokens, token{kind: tokNumber, num: n})
+		default:
+			return nil, fmt.Errorf("%w: unexpected character %q", ErrUnexpectedToken, string(r))
+		}
+	}
+	return append(tokens, token{kind: tokEOF}), nil
+}
+
+// parser is a recursive-descent parser over a token stream, implementing
+// the grammar:
+//
+//	expr   = term (('+'|'-') term)*
+//	term   = factor (('*'|'/'|'%') factor)*
+//	factor = ('+'|'-') factor | number | '(' expr ')'
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	p.pos++
+	return t
+}
+
+func (p *parser) parseExpr() (float64, error) {
+	value, err := p.parseTerm()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		switch p.peek().kind {
+		case tokPlus:
+			p.next()
+			rhs, err := p.parseTerm()
+			if err != nil {
+				return 0, err
+			}
+			value += rhs
+		case tokMinus:
+			p.next()
+			rhs, err := p.parseTerm()
+			if err != nil {
+				return 0, err
+			}
+			value -= rhs
+		default:
+			return value, nil
+		}
+	}
+}
+
+func (p *parser) parseTerm() (float64, error) {
+	value, err := p.parseFactor()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		switch p.peek().kind {
+		case tokStar:
+			p.next()
+			rhs, err := p.parseFactor()
+			if err != nil {
+				return 0, err
+			}
+			value *= rhs
+		case tokSlash:
+			p.next()
+			rhs, err := p.parseFactor()
+			if err != nil {
+				return 0, err
+			}
+			if rhs == 0 {
+				return 0, ErrDivisionByZero
+			}
+			value /= rhs
+		case tokPercent:
+			p.next()
+			rhs, err := p.parseFactor()
+			if err != nil {
+				return 0, err
+			}
+			if rhs == 0 {
+				return 0, ErrDivisionByZero
+			}
+			value = math.Mod(value, rhs)
+		default:
+			return value, nil
+		}
+	}
+}
+
+func (p *parser) parseFactor() (float64, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokPlus:
+		p.next()
+		return p.parseFactor()
+	case tokMinus:
+		p.next()
+		v, err := p.parseFactor()
+		if err != nil {
+			return 0, err
+		}
+		return -v, nil
+	case tokNumber:
+		p.next()
+		return t.num, nil
+	case tokLParen:
+		p.next()
+		v, err := p.parseExpr()
+		if err != nil {
+			return 0, err
+		}
+		if p.peek().kind != tokRParen {
+			return 0, fmt.Errorf("%w: expected ')'", ErrUnbalancedParens)
+		}
+		p.next()
+		return v, nil
+	default:
+		return 0, fmt.Errorf("%w: expected number or '('", ErrUnexpectedToken)
+	}
+}
+
+// Evaluate parses and evaluates a full infix arithmetic expression, such as
+// "-11.1+2.22*(73.45/6.463)" or "100 + ((2/4) * 2 * 3)". It supports the
+// operators +, -, *, /, % with conventional precedence (*, /, % bind
+// tighter than +, -), unary +/-, and parentheses for grouping.
+//
+// Parameters:
+//   - expr: The infix arithmetic expression to parse and evaluate
+//
+// Returns the computed value and an error wrapping ErrUnbalancedParens or
+// ErrUnexpectedToken if expr is malformed, or ErrDivisionByZero (checkable
+// with errors.Is) if a division or modulo by zero is attempted.
+func Evaluate(expr string) (float64, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return 0, err
+	}
+	p := &parser{tokens: tokens}
+	value, err := p.parseExpr()
+	if err != nil {
+		return 0, err
+	}
+	if p.peek().kind != tokEOF {
+		return 0, fmt.Errorf("%w: trailing input", ErrUnexpectedToken)
+	}
+	return value, nil
+}
@@ -0,0 +1,63 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want float64
+	}{
+		{"single number", "42", 42},
+		{"simple addition", "1+2", 3},
+		{"precedence", "2+3*4", 14},
+		{"parentheses override precedence", "(2+3)*4", 20},
+		{"nested parentheses", "100 + ((2/4) * 2 * 3)", 103},
+		{"unary minus", "-5+3", -2},
+		{"unary plus", "+5-3", 2},
+		{"modulo", "7%3", 1},
+		{"floats and whitespace", "-11.1 + 2.22 * (73.45 / 6.463)", -11.1 + 2.22*(73.45/6.463)},
+		{"exponent notation", "1e2+1", 101},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Evaluate(tt.expr)
+			if err != nil {
+				t.Fatalf("Evaluate(%q) returned error: %v", tt.expr, err)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Evaluate(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr error
+	}{
+		{"unbalanced opening paren", "(1+2", ErrUnbalancedParens},
+		{"unbalanced closing paren", "1+2)", ErrUnexpectedToken},
+		{"unexpected character", "1+@", ErrUnexpectedToken},
+		{"missing operand", "1+", ErrUnexpectedToken},
+		{"division by zero", "1/0", ErrDivisionByZero},
+		{"modulo by zero", "1%0", ErrDivisionByZero},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Evaluate(tt.expr)
+			if err == nil {
+				t.Fatalf("Evaluate(%q) returned no error, want one wrapping %v", tt.expr, tt.wantErr)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Evaluate(%q) error = %v, want errors.Is match for %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrInvalidOperand is returned by DivideF and ModuloF when an operand is
+// NaN or an infinity. AddF, SubtractF and MultiplyF do not check their
+// operands and never return it.
+var ErrInvalidOperand = errors.New("invalid operand: NaN or Inf")
+
+// AddF returns the sum of two floating-point numbers.
+//
+// Parameters:
+//   - a: The first operand
+//   - b: The second operand
+//
+// Returns the sum of a and b.
+func AddF(a, b float64) (float64, error) {
+	return a + b, nil
+}
+
+// SubtractF returns the difference between two floating-point numbers.
+//
+// Parameters:
+//   - a: The first operand (minuend)
+//   - b: The second operand (subtrahend)
+//
+// Returns the result of a - b.
+func SubtractF(a, b float64) (float64, error) {
+	return a - b, nil
+}
+
+// MultiplyF returns the product of two floating-point numbers.
+//
+// Parameters:
+//   - a: The first operand
+//   - b: The second operand
+//
+// Returns the product of a and b.
+func MultiplyF(a, b float64) (float64, error) {
+	return a * b, nil
+}
+
+// DivideF returns the quotient of two floating-point numbers.
+//
+// Parameters:
+//   - a: The dividend
+//   - b: The divisor
+//
+// Returns an error if b is exactly 0, or if a or b is NaN or infinite.
+func DivideF(a, b float64) (float64, error) {
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return 0, ErrInvalidOperand
+	}
+	if b == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return a / b, nil
+}
+
+// Modulo returns the remainder of a divided by b.
+//
+// Parameters:
+//   - a: The dividend
+//   - b: The divisor
+//
+// Returns an error if b is 0.
+func Modulo(a, b int) (int, error) {
+	if b == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return a % b, nil
+}
+
+// ModuloF returns the floating-point remainder of a divided by b, computed
+// with math.Mod.
+//
+// Parameters:
+//   - a: The dividend
+//   - b: The divisor
+//
+// Returns an error if b is exactly 0, or if a or b is NaN or infinite.
+func ModuloF(a, b float64) (float64, error) {
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return 0, ErrInvalidOperand
+	}
+	if b == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return math.Mod(a, b), nil
+}
@@ -0,0 +1,140 @@
+package calculator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrUnknownOperator is returned by Calculator.Do when called with an
+// operator other than "+", "-", "*", "/", or "%".
+var ErrUnknownOperator = errors.New("unknown operator")
+
+// Entry records a single operation applied to a Calculator: the operator,
+// the operand it was applied with, and the accumulator value immediately
+// after the operation.
+type Entry struct {
+	Op      string
+	Operand float64
+	Result  float64
+}
+
+// Calculator is a stateful accumulator that supports chained arithmetic
+// operations, a single memory register, and an operation history that can
+// be undone. The zero value is ready to use; New returns a pointer to one.
+type Calculator struct {
+	acc     float64
+	mem     float64
+	history []Entry
+}
+
+// New returns a Calculator.
+//
+// Returns a Calculator with its accumulator, memory, and history reset to
+// zero.
+func New() *Calculator {
+	return &Calculator{}
+}
+
+// Do applies an operation to the accumulator, enabling REPL-style usage,
+// e.g. c.Do("+", 5) followed by c.Do("*", 2).
+//
+// Parameters:
+//   - op: The operator to apply: "+", "-", "*", "/", or "%"
+//   - x: The operand to apply op with
+//
+// Returns the new accumulator value and an error if op is not recognized
+// or if a division or modulo by zero is attempted. A successful operation
+// is recorded in the history; a failed one leaves the accumulator and
+// history unchanged.
+func (c *Calculator) Do(op string, x float64) (float64, error) {
+	result, err := applyOp(op, c.acc, x)
+	if err != nil {
+		return 0, err
+	}
+	c.acc = result
+	c.history = append(c.history, Entry{Op: op, Operand: x, Result: result})
+	return c.acc, nil
+}
+
+// Result returns the current accumulator value.
+//
+// Returns the current accumulator value.
+func (c *Calculator) Result() float64 {
+	return c.acc
+}
+
+// Reset zeroes the accumulator and clears the history. Memory is left
+// untouched.
+func (c *Calculator) Reset() {
+	c.acc = 0
+	c.history = nil
+}
+
+// MemStore saves the current accumulator value into memory.
+func (c *Calculator) MemStore() {
+	c.mem = c.acc
+}
+
+// MemRecall returns the value currently held in memory.
+//
+// Returns the value currently held in memory.
+func (c *Calculator) MemRecall() float64 {
+	return c.mem
+}
+
+// MemAdd adds to the value held in memory.
+//
+// Parameters:
+//   - x: The value to add to memory
+func (c *Calculator) MemAdd(x float64) {
+	c.mem += x
+}
+
+// History returns the sequence of operations applied since the last Reset.
+//
+// Returns a copy of the sequence of operations applied since the last
+// Reset; mutating the result does not affect the Calculator.
+func (c *Calculator) History() []Entry {
+	return append([]Entry(nil), c.history...)
+}
+
+// Undo removes the most recently applied operation and recomputes the
+// accumulator from the remaining history. It is a no-op if there is no
+// history to undo.
+func (c *Calculator) Undo() {
+	if len(c.history) == 0 {
+		return
+	}
+	c.history = c.history[:len(c.history)-1]
+	c.acc = 0
+	for _, e := range c.history {
+		// The operation already succeeded once when it was first applied,
+		// so replaying it cannot fail.
+		c.acc, _ = applyOp(e.Op, c.acc, e.Operand)
+	}
+}
+
+// applyOp applies op to acc and x, shared by Do and Undo's replay.
+func applyOp(op string, acc, x float64) (float64, error) {
+	switch op {
+	case "+":
+		return acc + x, nil
+	case "-":
+		return acc - x, nil
+	case "*":
+		return acc * x, nil
+	case "/":
+		if x == 0 {
+			return 0, ErrDivisionByZero
+		}
+		return acc / x, nil
+	case "%":
+		if x == 0 {
+			return 0, ErrDivisionByZero
+		}
+		return math.Mod(acc, x), nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnknownOperator, op)
+	}
+}
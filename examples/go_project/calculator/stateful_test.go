@@ -0,0 +1,153 @@
+package calculator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCalculatorDo(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []Entry
+		want float64
+	}{
+		{
+			name: "chained operations",
+			ops: []Entry{
+				{Op: "+", Operand: 5},
+				{Op: "*", Operand: 2},
+				{Op: "-", Operand: 3},
+			},
+			want: 7,
+		},
+		{
+			name: "modulo",
+			ops: []Entry{
+				{Op: "+", Operand: 7},
+				{Op: "%", Operand: 3},
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			var got float64
+			var err error
+			for _, op := range tt.ops {
+				got, err = c.Do(op.Op, op.Operand)
+				if err != nil {
+					t.Fatalf("Do(%q, %v) returned error: %v", op.Op, op.Operand, err)
+				}
+			}
+			if got != tt.want {
+				t.Errorf("final result = %v, want %v", got, tt.want)
+			}
+			if c.Result() != tt.want {
+				t.Errorf("Result() = %v, want %v", c.Result(), tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculatorDoErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      string
+		x       float64
+		wantErr error
+	}{
+		{"division by zero", "/", 0, ErrDivisionByZero},
+		{"modulo by zero", "%", 0, ErrDivisionByZero},
+		{"unknown operator", "^", 2, ErrUnknownOperator},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			_, err := c.Do(tt.op, tt.x)
+			if err == nil {
+				t.Fatalf("Do(%q, %v) returned no error, want one wrapping %v", tt.op, tt.x, tt.wantErr)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Do(%q, %v) error = %v, want errors.Is match for %v", tt.op, tt.x, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCalculatorUndo(t *testing.T) {
+	c := New()
+	mustDo(t, c, "+", 5)
+	mustDo(t, c, "*", 2)
+	mustDo(t, c, "-", 3)
+	if got, want := c.Result(), 7.0; got != want {
+		t.Fatalf("Result() before Undo = %v, want %v", got, want)
+	}
+
+	c.Undo()
+	if got, want := c.Result(), 10.0; got != want {
+		t.Errorf("Result() after one Undo = %v, want %v", got, want)
+	}
+	if got, want := len(c.History()), 2; got != want {
+		t.Errorf("len(History()) after one Undo = %v, want %v", got, want)
+	}
+
+	c.Undo()
+	c.Undo()
+	if got, want := c.Result(), 0.0; got != want {
+		t.Errorf("Result() after undoing everything = %v, want %v", got, want)
+	}
+
+	// Undo beyond an empty history is a no-op.
+	c.Undo()
+	if got, want := c.Result(), 0.0; got != want {
+		t.Errorf("Result() after Undo on empty history = %v, want %v", got, want)
+	}
+}
+
+func TestCalculatorHistoryIsACopy(t *testing.T) {
+	c := New()
+	mustDo(t, c, "+", 5)
+
+	h := c.History()
+	h[0].Op = "bogus"
+
+	if got, want := c.History()[0].Op, "+"; got != want {
+		t.Errorf("mutating History() result affected Calculator: History()[0].Op = %v, want %v", got, want)
+	}
+}
+
+func TestCalculatorMemory(t *testing.T) {
+	c := New()
+	mustDo(t, c, "+", 5)
+	c.MemStore()
+	mustDo(t, c, "*", 10)
+	c.MemAdd(1)
+
+	if got, want := c.MemRecall(), 6.0; got != want {
+		t.Errorf("MemRecall() = %v, want %v", got, want)
+	}
+	if got, want := c.Result(), 50.0; got != want {
+		t.Errorf("Result() = %v, want %v", got, want)
+	}
+}
+
+func TestCalculatorReset(t *testing.T) {
+	c := New()
+	mustDo(t, c, "+", 5)
+	c.Reset()
+
+	if got, want := c.Result(), 0.0; got != want {
+		t.Errorf("Result() after Reset = %v, want %v", got, want)
+	}
+	if got, want := len(c.History()), 0; got != want {
+		t.Errorf("len(History()) after Reset = %v, want %v", got, want)
+	}
+}
+
+func mustDo(t *testing.T, c *Calculator, op string, x float64) {
+	t.Helper()
+	if _, err := c.Do(op, x); err != nil {
+		t.Fatalf("Do(%q, %v) returned error: %v", op, x, err)
+	}
+}
@@ -0,0 +1,100 @@
+// Command calc is a thin command-line front-end for the calculator
+// package. It parses flags for the supported operations and prints the
+// result to stdout, or an error to stderr with a non-zero exit code.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/JakubBrzezo/introligo/examples/go_project/calculator"
+)
+
+func main() {
+	addFlag := flag.String("add", "", "add two operands, given as a,b")
+	subtractFlag := flag.String("subtract", "", "subtract two operands, given as a,b")
+	multiplyFlag := flag.String("multiply", "", "multiply two operands, given as a,b")
+	divideFlag := flag.String("divide", "", "divide two operands, given as a,b")
+	moduloFlag := flag.String("modulo", "", "modulo of two operands, given as a,b")
+	exprFlag := flag.String("expr", "", "evaluate an arithmetic expression, e.g. \"1 + 2 * 3\"")
+	flag.Parse()
+
+	switch {
+	case *exprFlag != "":
+		report(calculator.Evaluate(*exprFlag))
+	case *addFlag != "":
+		report(applyF(*addFlag, "add", calculator.AddF))
+	case *subtractFlag != "":
+		report(applyF(*subtractFlag, "subtract", calculator.SubtractF))
+	case *multiplyFlag != "":
+		report(applyF(*multiplyFlag, "multiply", calculator.MultiplyF))
+	case *divideFlag != "":
+		report(applyF(*divideFlag, "divide", calculator.DivideF))
+	case *moduloFlag != "":
+		report(applyF(*moduloFlag, "modulo", calculator.ModuloF))
+	default:
+		fmt.Fprintln(os.Stderr, "calc: no operation specified")
+		flag.Usage()
+		os.Exit(1)
+	}
+}
+
+// applyF parses a flag value as two comma-separated float64 operands and
+// applies op to them.
+//
+// Parameters:
+//   - value: The flag value, e.g. "3,5"
+//   - name: The flag name, used to label any error
+//   - op: The calculator function to apply to the two operands
+//
+// Returns the result of op and an error if value cannot be parsed as two
+// operands or if op itself fails.
+func applyF(value, name string, op func(a, b float64) (float64, error)) (float64, error) {
+	a, b, err := parseOperands(name, value)
+	if err != nil {
+		return 0, err
+	}
+	return op(a, b)
+}
+
+// parseOperands splits a flag value into two comma-separated float64
+// operands.
+//
+// Parameters:
+//   - name: The flag name, used to label any error
+//   - value: The flag value, e.g. "3,5"
+//
+// Returns the two parsed operands and an error if value is not exactly two
+// comma-separated numbers.
+func parseOperands(name, value string) (float64, float64, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("-%s expects two comma-separated operands, e.g. -%s=3,5", name, name)
+	}
+	a, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("-%s: invalid first operand %q", name, parts[0])
+	}
+	b, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("-%s: invalid second operand %q", name, parts[1])
+	}
+	return a, b, nil
+}
+
+// report prints a result to stdout, or an error to stderr with a non-zero
+// exit code.
+//
+// Parameters:
+//   - result: The value to print on success
+//   - err: The error to report, if any
+func report(result float64, err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "calc:", err)
+		os.Exit(1)
+	}
+	fmt.Println(result)
+}